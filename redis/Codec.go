@@ -0,0 +1,69 @@
+package redis
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Codec encodes and decodes values for storage in Redis, letting Client.WithCodec
+// plug in a serialization format other than the JSON used by SetJSON/GetJSON.
+type Codec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+	Name() string
+}
+
+// JSONCodec encodes values with encoding/json.
+type JSONCodec struct{}
+
+func (JSONCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (JSONCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func (JSONCodec) Name() string {
+	return "json"
+}
+
+// GobCodec encodes values with encoding/gob. The values passed to Marshal and the
+// destination passed to Unmarshal must be gob-registered or of a concrete type known
+// at compile time.
+type GobCodec struct{}
+
+func (GobCodec) Marshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (GobCodec) Unmarshal(data []byte, v interface{}) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+func (GobCodec) Name() string {
+	return "gob"
+}
+
+// MsgpackCodec encodes values with MessagePack, which is typically more compact and
+// faster to (de)serialize than JSON.
+type MsgpackCodec struct{}
+
+func (MsgpackCodec) Marshal(v interface{}) ([]byte, error) {
+	return msgpack.Marshal(v)
+}
+
+func (MsgpackCodec) Unmarshal(data []byte, v interface{}) error {
+	return msgpack.Unmarshal(data, v)
+}
+
+func (MsgpackCodec) Name() string {
+	return "msgpack"
+}
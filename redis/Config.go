@@ -1,8 +1,19 @@
 package redis
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
+)
+
+// Deployment modes supported by Config.Mode.
+const (
+	ModeSingle   = "single"
+	ModeSentinel = "sentinel"
+	ModeCluster  = "cluster"
 )
 
 type Config struct {
@@ -10,22 +21,88 @@ type Config struct {
 	Port     string `yaml:"port"`
 	Password string `yaml:"password"`
 	DB       int    `yaml:"db"`
+
+	// Addrs lists the node addresses for Sentinel/Cluster deployments. When empty,
+	// GetAddrs falls back to a single-element slice built from Host and Port.
+	Addrs []string `yaml:"addrs"`
+	// MasterName is the Sentinel master set name. Required when Mode is "sentinel".
+	MasterName string `yaml:"masterName"`
+	// Mode selects the deployment topology: "single", "sentinel", or "cluster".
+	Mode string `yaml:"mode"`
+
+	DialTimeout  time.Duration `yaml:"dialTimeout"`
+	ReadTimeout  time.Duration `yaml:"readTimeout"`
+	WriteTimeout time.Duration `yaml:"writeTimeout"`
+	PoolSize     int           `yaml:"poolSize"`
+	MinIdleConns int           `yaml:"minIdleConns"`
+	IdleTimeout  time.Duration `yaml:"idleTimeout"`
+
+	// Logger receives connection and diagnostic messages. It defaults to a logger
+	// backed by the standard log package when left nil.
+	Logger Logger `yaml:"-"`
 }
 
 func NewConfig() *Config {
 	db, _ := strconv.Atoi(getEnv("REDIS_DB", "0"))
+	poolSize, _ := strconv.Atoi(getEnv("REDIS_POOL_SIZE", "0"))
+	minIdleConns, _ := strconv.Atoi(getEnv("REDIS_MIN_IDLE_CONNS", "0"))
+
 	return &Config{
 		Host:     getEnv("REDIS_HOST", "localhost"),
 		Port:     getEnv("REDIS_PORT", "6379"),
 		Password: getEnv("REDIS_PASSWORD", ""),
 		DB:       db,
+
+		Addrs:      getEnvList("REDIS_ADDRS"),
+		MasterName: getEnv("REDIS_MASTER_NAME", ""),
+		Mode:       getEnv("REDIS_MODE", ModeSingle),
+
+		DialTimeout:  getEnvDuration("REDIS_DIAL_TIMEOUT", 0),
+		ReadTimeout:  getEnvDuration("REDIS_READ_TIMEOUT", 0),
+		WriteTimeout: getEnvDuration("REDIS_WRITE_TIMEOUT", 0),
+		PoolSize:     poolSize,
+		MinIdleConns: minIdleConns,
+		IdleTimeout:  getEnvDuration("REDIS_IDLE_TIMEOUT", 0),
 	}
 }
 
+// GetAddr returns the single-node address built from Host and Port.
 func (c *Config) GetAddr() string {
 	return c.Host + ":" + c.Port
 }
 
+// GetAddrs returns the node addresses to dial. It returns Addrs when set, otherwise
+// falls back to a single-element slice built from Host and Port so existing
+// single-node configs keep working unchanged.
+func (c *Config) GetAddrs() []string {
+	if len(c.Addrs) > 0 {
+		return c.Addrs
+	}
+	return []string{c.GetAddr()}
+}
+
+// Validate reports an error when Mode disagrees with the rest of the config, e.g.
+// Mode is "cluster" with no Addrs configured, or Mode is "sentinel" with no
+// MasterName. NewRedis calls this before dialing so a misconfigured Mode fails
+// loudly instead of silently falling back to a single-node client.
+func (c *Config) Validate() error {
+	switch c.Mode {
+	case "", ModeSingle, ModeSentinel, ModeCluster:
+	default:
+		return fmt.Errorf("redis: unknown Config.Mode %q", c.Mode)
+	}
+	if c.Mode == ModeSentinel && c.MasterName == "" {
+		return errors.New("redis: Config.Mode is \"sentinel\" but MasterName is empty")
+	}
+	if c.Mode == ModeCluster && len(c.Addrs) == 0 {
+		return errors.New("redis: Config.Mode is \"cluster\" but no Addrs are configured")
+	}
+	if c.Mode != ModeSentinel && c.MasterName != "" {
+		return fmt.Errorf("redis: MasterName is set but Config.Mode is %q, not %q", c.Mode, ModeSentinel)
+	}
+	return nil
+}
+
 func getEnv(key, defaultValue string) string {
 	value := os.Getenv(key)
 	if value == "" {
@@ -33,3 +110,34 @@ func getEnv(key, defaultValue string) string {
 	}
 	return value
 }
+
+// getEnvList parses a comma-separated environment variable into a slice, returning
+// nil if it is unset or empty.
+func getEnvList(key string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return nil
+	}
+	parts := strings.Split(value, ",")
+	addrs := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			addrs = append(addrs, p)
+		}
+	}
+	return addrs
+}
+
+// getEnvDuration parses an environment variable as a duration (e.g. "5s"), falling
+// back to defaultValue if it is unset or invalid.
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return defaultValue
+	}
+	return d
+}
@@ -0,0 +1,200 @@
+package redis
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrLockNotAcquired is returned by TryLock when the key is already held by another owner.
+var ErrLockNotAcquired = errors.New("redis: lock not acquired")
+
+// unlockScript deletes the lock key only if it is still owned by the caller's token.
+var unlockScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+else
+	return 0
+end
+`)
+
+// refreshScript extends the lock key's TTL only if it is still owned by the caller's token.
+var refreshScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("pexpire", KEYS[1], ARGV[2])
+else
+	return 0
+end
+`)
+
+// Lock represents a distributed lock held on a single key. It is safe for concurrent
+// use only through the Client that created it.
+type Lock struct {
+	client     *Client
+	key        string
+	token      string
+	expiration time.Duration
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+}
+
+// Key returns the locked key.
+func (l *Lock) Key() string {
+	return l.key
+}
+
+// newLockToken generates a random token used to identify the owner of a lock.
+func newLockToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// Lock blocks, retrying every 100ms, until the lock at key is acquired or ctx is done.
+//
+// Example:
+//
+//	lock, err := redisClient.Lock(ctx, "lock:job1", 10*time.Second)
+func (r *Client) Lock(ctx context.Context, key string, expiration time.Duration) (*Lock, error) {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		lock, err := r.TryLock(ctx, key, expiration)
+		if err == nil {
+			return lock, nil
+		}
+		if !errors.Is(err, ErrLockNotAcquired) {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// TryLock attempts to acquire the lock at key exactly once, returning ErrLockNotAcquired
+// if it is already held. On success it starts a watchdog goroutine that renews the TTL
+// every expiration/3 until Unlock is called or ctx is done. A lock whose caller never
+// calls Unlock stops being renewed once ctx is done and then expires server-side;
+// while ctx stays live it keeps being renewed.
+//
+// Example:
+//
+//	lock, err := redisClient.TryLock(ctx, "lock:job1", 10*time.Second)
+func (r *Client) TryLock(ctx context.Context, key string, expiration time.Duration) (*Lock, error) {
+	token, err := newLockToken()
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := r.Client.SetNX(ctx, key, token, expiration).Result()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrLockNotAcquired
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	lock := &Lock{
+		client:     r,
+		key:        key,
+		token:      token,
+		expiration: expiration,
+		cancel:     cancel,
+	}
+	go lock.watchdog(watchCtx)
+
+	return lock, nil
+}
+
+// watchdog keeps renewing the lock's TTL at expiration/3 intervals until ctx is
+// cancelled, which happens when Unlock is called or when the ctx passed to TryLock
+// is itself done. expiration is read under l.mu on every iteration since Refresh may
+// concurrently update it.
+func (l *Lock) watchdog(ctx context.Context) {
+	for {
+		l.mu.Lock()
+		expiration := l.expiration
+		l.mu.Unlock()
+
+		interval := expiration / 3
+		if interval <= 0 {
+			return
+		}
+		timer := time.NewTimer(interval)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+			l.mu.Lock()
+			expiration = l.expiration
+			l.mu.Unlock()
+			_ = refreshScript.Run(ctx, l.client.Client, []string{l.key}, l.token, expiration.Milliseconds()).Err()
+		}
+	}
+}
+
+// Unlock releases lock if it is still owned by the caller and stops its watchdog
+// goroutine. Releasing a lock that has already expired or been taken over by another
+// owner is a no-op.
+//
+// Example:
+//
+//	err := redisClient.Unlock(ctx, lock)
+func (r *Client) Unlock(ctx context.Context, lock *Lock) error {
+	lock.mu.Lock()
+	defer lock.mu.Unlock()
+
+	if lock.cancel != nil {
+		lock.cancel()
+		lock.cancel = nil
+	}
+	return unlockScript.Run(ctx, r.Client, []string{lock.key}, lock.token).Err()
+}
+
+// Refresh extends lock's TTL to expiration if it is still owned by the caller.
+//
+// Example:
+//
+//	err := redisClient.Refresh(ctx, lock, 10*time.Second)
+func (r *Client) Refresh(ctx context.Context, lock *Lock, expiration time.Duration) error {
+	lock.mu.Lock()
+	defer lock.mu.Unlock()
+
+	lock.expiration = expiration
+	return refreshScript.Run(ctx, r.Client, []string{lock.key}, lock.token, expiration.Milliseconds()).Err()
+}
+
+// WithLock acquires the lock at key, runs fn, and releases the lock afterwards
+// regardless of whether fn returns an error.
+//
+// Example:
+//
+//	err := redisClient.WithLock(ctx, "lock:job1", 10*time.Second, func(ctx context.Context) error {
+//	    return doWork(ctx)
+//	})
+func (r *Client) WithLock(ctx context.Context, key string, expiration time.Duration, fn func(ctx context.Context) error) error {
+	lock, err := r.Lock(ctx, key, expiration)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = r.Unlock(ctx, lock)
+	}()
+	return fn(ctx)
+}
@@ -0,0 +1,101 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// newTestClient starts an in-process miniredis server and wraps it in a Client,
+// so Lock's CAS Lua scripts run against something real without needing a live
+// Redis server in CI.
+func newTestClient(t *testing.T) (*Client, *miniredis.Miniredis) {
+	t.Helper()
+
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start miniredis: %v", err)
+	}
+	t.Cleanup(mr.Close)
+
+	rdb := goredis.NewClient(&goredis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	return &Client{rdb}, mr
+}
+
+func TestClient_Unlock_NotOwner_IsNoOp(t *testing.T) {
+	ctx := context.Background()
+	client, mr := newTestClient(t)
+
+	lock, err := client.TryLock(ctx, "resource", time.Minute)
+	if err != nil {
+		t.Fatalf("TryLock: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Unlock(ctx, lock) })
+
+	impostor := &Lock{client: client, key: lock.Key(), token: "not-the-owner"}
+	if err := client.Unlock(ctx, impostor); err != nil {
+		t.Fatalf("Unlock by a non-owner token returned an error: %v", err)
+	}
+
+	if !mr.Exists(lock.Key()) {
+		t.Fatalf("Unlock by a non-owner token deleted the key; it should be a no-op")
+	}
+}
+
+func TestClient_TryLock_WatchdogStopsOnUnlock(t *testing.T) {
+	ctx := context.Background()
+	client, mr := newTestClient(t)
+
+	lock, err := client.TryLock(ctx, "resource", 300*time.Millisecond)
+	if err != nil {
+		t.Fatalf("TryLock: %v", err)
+	}
+
+	// Give the watchdog a chance to renew the key at least once before we release it.
+	time.Sleep(150 * time.Millisecond)
+
+	if err := client.Unlock(ctx, lock); err != nil {
+		t.Fatalf("Unlock: %v", err)
+	}
+
+	// Put the key back under the same token with little TTL left, then wait past a
+	// renewal interval (expiration/3). A watchdog still running after Unlock would
+	// renew the TTL back to 300ms, so the key would survive the final fast-forward.
+	if err := mr.Set(lock.Key(), lock.token); err != nil {
+		t.Fatalf("re-seed key: %v", err)
+	}
+	mr.SetTTL(lock.Key(), 50*time.Millisecond)
+	time.Sleep(150 * time.Millisecond)
+
+	mr.FastForward(100 * time.Millisecond)
+	if mr.Exists(lock.Key()) {
+		t.Fatalf("key still exists past its TTL; Unlock did not stop the watchdog")
+	}
+}
+
+func TestClient_TryLock_WatchdogStopsOnContextCancel(t *testing.T) {
+	client, mr := newTestClient(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	lock, err := client.TryLock(ctx, "resource", 200*time.Millisecond)
+	if err != nil {
+		t.Fatalf("TryLock: %v", err)
+	}
+	cancel()
+
+	// Use up most of the key's TTL, then wait past a renewal interval (expiration/3).
+	// A watchdog still running after the cancel would renew the TTL back to 200ms,
+	// so the key would survive the final fast-forward.
+	mr.FastForward(150 * time.Millisecond)
+	time.Sleep(150 * time.Millisecond)
+
+	mr.FastForward(100 * time.Millisecond)
+	if mr.Exists(lock.Key()) {
+		t.Fatalf("key still exists past its TTL; cancelling ctx did not stop the watchdog")
+	}
+}
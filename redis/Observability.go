@@ -0,0 +1,167 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Logger receives connection and diagnostic messages from Client.
+type Logger interface {
+	Printf(ctx context.Context, format string, args ...interface{})
+}
+
+// defaultLogger logs through the standard log package.
+type defaultLogger struct{}
+
+func (defaultLogger) Printf(_ context.Context, format string, args ...interface{}) {
+	log.Printf(format, args...)
+}
+
+var (
+	commandDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "redis_client",
+		Name:      "command_duration_seconds",
+		Help:      "Duration of Redis commands, labeled by command name and outcome.",
+	}, []string{"command", "status"})
+
+	commandsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "redis_client",
+		Name:      "commands_total",
+		Help:      "Total number of Redis commands executed, labeled by command name and outcome.",
+	}, []string{"command", "status"})
+)
+
+func init() {
+	prometheus.MustRegister(commandDuration, commandsTotal)
+}
+
+// observabilityHook is a redis.Hook that records Prometheus metrics and an
+// OpenTelemetry span for every command and pipeline executed by Client.
+type observabilityHook struct {
+	tracer trace.Tracer
+	addr   string
+	db     int
+}
+
+// newObservabilityHook builds the hook registered on every Client by NewRedis.
+func newObservabilityHook(addrs []string, db int) *observabilityHook {
+	return &observabilityHook{
+		tracer: otel.Tracer("github.com/kimxuanhong/go-redis"),
+		addr:   strings.Join(addrs, ","),
+		db:     db,
+	}
+}
+
+// DialHook is a no-op; connection pool health is exposed separately via PoolStats.
+func (h *observabilityHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+// ProcessHook records a span and metrics around a single command.
+func (h *observabilityHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		start := time.Now()
+		ctx, span := h.tracer.Start(ctx, cmd.Name(), trace.WithAttributes(
+			attribute.String("db.redis.addr", h.addr),
+			attribute.Int("db.redis.db", h.db),
+			attribute.Int("db.redis.args_count", len(cmd.Args())),
+		))
+		defer span.End()
+
+		err := next(ctx, cmd)
+		h.observe(cmd.Name(), err, start, span)
+		return err
+	}
+}
+
+// ProcessPipelineHook records a span and metrics around a batch of pipelined commands.
+func (h *observabilityHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		start := time.Now()
+		ctx, span := h.tracer.Start(ctx, "pipeline", trace.WithAttributes(
+			attribute.String("db.redis.addr", h.addr),
+			attribute.Int("db.redis.db", h.db),
+			attribute.Int("db.redis.commands_count", len(cmds)),
+		))
+		defer span.End()
+
+		err := next(ctx, cmds)
+		h.observe("pipeline", err, start, span)
+		return err
+	}
+}
+
+// observe records the outcome of a command or pipeline run on both the Prometheus
+// metrics and the OpenTelemetry span.
+func (h *observabilityHook) observe(command string, err error, start time.Time, span trace.Span) {
+	status := "ok"
+	if err != nil && err != redis.Nil {
+		status = "error"
+		span.RecordError(err)
+	}
+	commandDuration.WithLabelValues(command, status).Observe(time.Since(start).Seconds())
+	commandsTotal.WithLabelValues(command, status).Inc()
+}
+
+// poolStatsCollector is a prometheus.Collector that samples a Client's connection
+// pool stats on every scrape, so pool exhaustion and connection churn show up
+// without the caller having to poll Client.PoolStats() themselves.
+type poolStatsCollector struct {
+	client *Client
+	desc   *prometheus.Desc
+}
+
+// newPoolStatsCollector builds the collector registered for every Client by
+// NewRedis. addr is baked into the descriptor as a const label so one collector per
+// Client can be registered without colliding with another Client's metrics.
+func newPoolStatsCollector(client *Client, addr string) *poolStatsCollector {
+	return &poolStatsCollector{
+		client: client,
+		desc: prometheus.NewDesc(
+			"redis_client_pool_stats",
+			"Redis connection pool statistics, labeled by stat (hits, misses, timeouts, total_conns, idle_conns, stale_conns).",
+			[]string{"stat"},
+			prometheus.Labels{"addr": addr},
+		),
+	}
+}
+
+func (c *poolStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *poolStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.client.Client.PoolStats()
+	if stats == nil {
+		return
+	}
+	emit := func(stat string, value float64) {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, value, stat)
+	}
+	emit("hits", float64(stats.Hits))
+	emit("misses", float64(stats.Misses))
+	emit("timeouts", float64(stats.Timeouts))
+	emit("total_conns", float64(stats.TotalConns))
+	emit("idle_conns", float64(stats.IdleConns))
+	emit("stale_conns", float64(stats.StaleConns))
+}
+
+// registerPoolStatsCollector registers client's pool stats collector, tolerating an
+// AlreadyRegisteredError so reconnecting to the same addr doesn't make NewRedis fail.
+func registerPoolStatsCollector(client *Client, addr string) error {
+	err := prometheus.Register(newPoolStatsCollector(client, addr))
+	var alreadyRegistered prometheus.AlreadyRegisteredError
+	if err != nil && !errors.As(err, &alreadyRegistered) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package redis
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Pipeliner batches commands (Set, Get, Incr, Expire, LPush, Del, and the rest of
+// the core command set) for a single round trip, resolving their results when Exec
+// is called.
+type Pipeliner = redis.Pipeliner
+
+// Tx represents a transaction started by Watch, scoped to the watched keys.
+type Tx = redis.Tx
+
+// Pipeline returns a Pipeliner that batches commands and sends them to Redis in a
+// single round trip when Exec is called. Unlike TxPipeline, the batch is not
+// wrapped in MULTI/EXEC.
+//
+// Example:
+//
+//	pipe := redisClient.Pipeline(ctx)
+//	incr := pipe.Incr(ctx, "counter")
+//	pipe.Expire(ctx, "counter", time.Hour)
+//	_, err := pipe.Exec(ctx)
+func (r *Client) Pipeline(ctx context.Context) Pipeliner {
+	return r.Client.Pipeline()
+}
+
+// TxPipeline returns a Pipeliner that batches commands into a single MULTI/EXEC
+// round trip, so they execute atomically.
+//
+// Example:
+//
+//	pipe := redisClient.TxPipeline(ctx)
+//	pipe.Incr(ctx, "counter")
+//	pipe.Expire(ctx, "counter", time.Hour)
+//	_, err := pipe.Exec(ctx)
+func (r *Client) TxPipeline(ctx context.Context) Pipeliner {
+	return r.Client.TxPipeline()
+}
+
+// Watch runs fn within a WATCH/MULTI/EXEC transaction on the given keys, enabling
+// optimistic-locking compare-and-swap patterns. If a watched key changes before
+// fn's pipeline executes, fn returns redis.TxFailedErr and the caller should retry.
+//
+// Example:
+//
+//	err := redisClient.Watch(ctx, func(tx *redis.Tx) error {
+//	    val, err := tx.Get(ctx, "counter").Int()
+//	    if err != nil && err != redis.Nil {
+//	        return err
+//	    }
+//	    _, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+//	        pipe.Set(ctx, "counter", val+1, 0)
+//	        return nil
+//	    })
+//	    return err
+//	}, "counter")
+func (r *Client) Watch(ctx context.Context, fn func(tx *Tx) error, keys ...string) error {
+	return r.Client.Watch(ctx, fn, keys...)
+}
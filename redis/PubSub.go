@@ -0,0 +1,107 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Message represents a single Pub/Sub message delivered on a subscribed channel
+// or pattern.
+type Message struct {
+	Channel string
+	Pattern string
+	Payload string
+}
+
+// Publish publishes payload on channel.
+//
+// Example:
+//
+//	err := redisClient.Publish(ctx, "events", "user.created")
+func (r *Client) Publish(ctx context.Context, channel string, payload interface{}) error {
+	return r.Client.Publish(ctx, channel, payload).Err()
+}
+
+// Subscribe listens on the given channels and streams incoming messages on the
+// returned channel until ctx is done, at which point the subscription is closed and
+// the returned channel is closed too.
+//
+// Example:
+//
+//	messages, err := redisClient.Subscribe(ctx, "events")
+func (r *Client) Subscribe(ctx context.Context, channels ...string) (<-chan *Message, error) {
+	pubsub := r.Client.Subscribe(ctx, channels...)
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, err
+	}
+	return relayMessages(ctx, pubsub), nil
+}
+
+// PSubscribe listens on channels matching the given glob-style patterns and streams
+// incoming messages on the returned channel until ctx is done.
+//
+// Example:
+//
+//	messages, err := redisClient.PSubscribe(ctx, "events.*")
+func (r *Client) PSubscribe(ctx context.Context, patterns ...string) (<-chan *Message, error) {
+	pubsub := r.Client.PSubscribe(ctx, patterns...)
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, err
+	}
+	return relayMessages(ctx, pubsub), nil
+}
+
+// relayMessages forwards messages from pubsub onto a buffered channel, closing the
+// subscription and the returned channel once ctx is done.
+func relayMessages(ctx context.Context, pubsub *redis.PubSub) <-chan *Message {
+	out := make(chan *Message, 100)
+	go func() {
+		defer close(out)
+		defer pubsub.Close()
+
+		ch := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-ch:
+				if !ok {
+					return
+				}
+				select {
+				case out <- &Message{Channel: msg.Channel, Pattern: msg.Pattern, Payload: msg.Payload}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
+
+// Enqueue pushes a raw string payload onto the Redis list at key so it can be
+// consumed by a Worker via blocking BRPOP.
+//
+// Example:
+//
+//	err := redisClient.Enqueue(ctx, "jobs", "job-payload")
+func (r *Client) Enqueue(ctx context.Context, key string, payload string) error {
+	return r.Client.LPush(ctx, key, payload).Err()
+}
+
+// EnqueueJSON marshals payload to JSON and pushes it onto the Redis list at key.
+//
+// Example:
+//
+//	err := redisClient.EnqueueJSON(ctx, "jobs", Job{ID: "1"})
+func (r *Client) EnqueueJSON(ctx context.Context, key string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return r.Client.LPush(ctx, key, data).Err()
+}
@@ -0,0 +1,132 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrRedLockNotAcquired is returned when a majority of RedLock's nodes could not be
+// locked within the allowed clock drift.
+var ErrRedLockNotAcquired = errors.New("redis: redlock not acquired on a majority of nodes")
+
+// driftFactor bounds the clock drift assumed between RedLock's nodes, following the
+// Redlock algorithm's recommended default.
+const driftFactor = 0.01
+
+// RedLock acquires a lock across N independent Redis nodes using the Redlock
+// algorithm, tolerating the failure of a minority of them.
+type RedLock struct {
+	clients []Redis
+}
+
+// NewRedLock builds a RedLock coordinator over the given independent Redis nodes.
+// clients must point at separate Redis instances, not replicas of one another, for
+// the algorithm's guarantees to hold.
+//
+// Example:
+//
+//	rl := redis.NewRedLock([]redis.Redis{node1, node2, node3})
+func NewRedLock(clients []Redis) *RedLock {
+	return &RedLock{clients: clients}
+}
+
+// RedLockHandle represents a lock held across a majority of RedLock's nodes.
+type RedLockHandle struct {
+	redLock  *RedLock
+	locks    []*Lock
+	validity time.Duration
+}
+
+// Validity returns the time the caller could safely assume to hold the lock, as
+// computed when Lock returned; it does not count down afterwards.
+func (h *RedLockHandle) Validity() time.Duration {
+	return h.validity
+}
+
+// Unlock releases the lock on every node that acquired it.
+//
+// Example:
+//
+//	err := lock.Unlock(ctx)
+func (h *RedLockHandle) Unlock(ctx context.Context) error {
+	h.redLock.releaseAll(ctx, h.locks)
+	return nil
+}
+
+// nodeTimeoutFactor bounds how long Lock waits on any single node, following the
+// Redlock algorithm's recommendation that it be small compared to the lock's TTL so
+// one slow or down node can't stall the whole acquisition.
+const nodeTimeoutFactor = 0.1
+
+// minNodeTimeout is the floor applied to the per-node timeout derived from
+// expiration, so very short TTLs still leave each node a workable window.
+const minNodeTimeout = 50 * time.Millisecond
+
+// Lock acquires the lock at key on a majority of nodes within a bounded clock skew,
+// releasing it everywhere and returning ErrRedLockNotAcquired if it fails to do so.
+// Nodes are contacted in parallel, each bounded by a timeout derived from expiration,
+// so a single down or slow node can't stall acquisition even if ctx has no deadline.
+//
+// Example:
+//
+//	lock, err := rl.Lock(ctx, "lock:job1", 10*time.Second)
+func (rl *RedLock) Lock(ctx context.Context, key string, expiration time.Duration) (*RedLockHandle, error) {
+	quorum := len(rl.clients)/2 + 1
+	locks := make([]*Lock, len(rl.clients))
+
+	nodeTimeout := time.Duration(float64(expiration) * nodeTimeoutFactor)
+	if nodeTimeout < minNodeTimeout {
+		nodeTimeout = minNodeTimeout
+	}
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	wg.Add(len(rl.clients))
+	for i, client := range rl.clients {
+		i, client := i, client
+		go func() {
+			defer wg.Done()
+
+			nodeCtx, cancel := context.WithTimeout(ctx, nodeTimeout)
+			defer cancel()
+
+			lock, err := client.TryLock(nodeCtx, key, expiration)
+			if err != nil {
+				return
+			}
+			locks[i] = lock
+		}()
+	}
+	wg.Wait()
+
+	var acquired int
+	for _, lock := range locks {
+		if lock != nil {
+			acquired++
+		}
+	}
+
+	drift := time.Duration(float64(expiration)*driftFactor) + 2*time.Millisecond
+	validity := expiration - time.Since(start) - drift
+
+	if acquired < quorum || validity <= 0 {
+		rl.releaseAll(context.Background(), locks)
+		return nil, ErrRedLockNotAcquired
+	}
+
+	return &RedLockHandle{redLock: rl, locks: locks, validity: validity}, nil
+}
+
+// releaseAll unlocks the lock on every node that holds one. Individual failures are
+// ignored since a best-effort release across the remaining nodes is still safer than
+// leaking the lock until its TTL expires.
+func (rl *RedLock) releaseAll(ctx context.Context, locks []*Lock) {
+	for i, lock := range locks {
+		if lock == nil {
+			continue
+		}
+		_ = rl.clients[i].Unlock(ctx, lock)
+	}
+}
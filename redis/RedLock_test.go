@@ -0,0 +1,42 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRedLock_FailsAndReleasesEverywhereWhenQuorumNotMet(t *testing.T) {
+	ctx := context.Background()
+	const key = "resource"
+
+	nodeA, mrA := newTestClient(t)
+	nodeB, mrB := newTestClient(t)
+	nodeC, mrC := newTestClient(t)
+
+	// Seed nodes B and C as already locked by someone else, so RedLock can only
+	// acquire a minority (A) of the 3 independent nodes and must fail the quorum check.
+	if _, err := nodeB.TryLock(ctx, key, time.Minute); err != nil {
+		t.Fatalf("seed lock on node B: %v", err)
+	}
+	if _, err := nodeC.TryLock(ctx, key, time.Minute); err != nil {
+		t.Fatalf("seed lock on node C: %v", err)
+	}
+
+	rl := NewRedLock([]Redis{nodeA, nodeB, nodeC})
+	lock, err := rl.Lock(ctx, key, time.Second)
+	if !errors.Is(err, ErrRedLockNotAcquired) {
+		t.Fatalf("expected ErrRedLockNotAcquired, got %v (lock=%v)", err, lock)
+	}
+
+	if mrA.Exists(key) {
+		t.Fatalf("node A's lock was not released after RedLock failed to reach quorum")
+	}
+	if !mrC.Exists(key) {
+		t.Fatalf("node C's pre-existing lock should be left untouched by release-on-failure")
+	}
+	if !mrB.Exists(key) {
+		t.Fatalf("node B's pre-existing lock should be left untouched by release-on-failure")
+	}
+}
@@ -3,7 +3,7 @@ package redis
 import (
 	"context"
 	"encoding/json"
-	"log"
+	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -25,12 +25,34 @@ type Redis interface {
 	Exists(ctx context.Context, key string) (bool, error)
 	SetJSON(ctx context.Context, key string, value interface{}, expiration time.Duration) error
 	GetJSON(ctx context.Context, key string, dest interface{}) error
+	Lock(ctx context.Context, key string, expiration time.Duration) (*Lock, error)
+	TryLock(ctx context.Context, key string, expiration time.Duration) (*Lock, error)
+	Unlock(ctx context.Context, lock *Lock) error
+	Refresh(ctx context.Context, lock *Lock, expiration time.Duration) error
+	WithLock(ctx context.Context, key string, expiration time.Duration, fn func(ctx context.Context) error) error
+	Publish(ctx context.Context, channel string, payload interface{}) error
+	Subscribe(ctx context.Context, channels ...string) (<-chan *Message, error)
+	PSubscribe(ctx context.Context, patterns ...string) (<-chan *Message, error)
+	Enqueue(ctx context.Context, key string, payload string) error
+	EnqueueJSON(ctx context.Context, key string, payload interface{}) error
+	Pipeline(ctx context.Context) Pipeliner
+	TxPipeline(ctx context.Context) Pipeliner
+	Watch(ctx context.Context, fn func(tx *Tx) error, keys ...string) error
+	XAdd(ctx context.Context, stream string, values map[string]interface{}) (string, error)
+	XReadGroup(ctx context.Context, group, consumer string, streams ...string) ([]redis.XStream, error)
+	XAck(ctx context.Context, stream, group string, ids ...string) (int64, error)
+	XPending(ctx context.Context, stream, group string) (*redis.XPending, error)
+	XClaim(ctx context.Context, stream, group, consumer string, minIdle time.Duration, ids ...string) ([]redis.XMessage, error)
+	PoolStats() *redis.PoolStats
+	HealthCheck(ctx context.Context) error
 	Close() error
 }
 
-// Client wraps a Client client
+// Client wraps a Client client. The underlying redis.UniversalClient transparently
+// targets a single node, a Sentinel-managed failover group, or a Cluster deployment
+// depending on Config.Mode.
 type Client struct {
-	*redis.Client
+	Client redis.UniversalClient
 }
 
 // NewRedis initializes a new Client client based on the given configuration
@@ -43,19 +65,111 @@ type Client struct {
 //	    log.Fatal(err)
 //	}
 func NewRedis(cfg *Config) (Redis, error) {
-	client := redis.NewClient(&redis.Options{
-		Addr:     cfg.GetAddr(),
-		Password: cfg.Password,
-		DB:       cfg.DB,
-	})
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	client := newUniversalClient(cfg)
+	client.AddHook(newObservabilityHook(cfg.GetAddrs(), cfg.DB))
+
+	logger := cfg.Logger
+	if logger == nil {
+		logger = defaultLogger{}
+	}
 
 	ctx := context.Background()
 	if err := client.Ping(ctx).Err(); err != nil {
 		return nil, err
 	}
 
-	log.Println("Successfully connected to Client")
-	return &Client{client}, nil
+	redisClient := &Client{client}
+	if err := registerPoolStatsCollector(redisClient, strings.Join(cfg.GetAddrs(), ",")); err != nil {
+		return nil, err
+	}
+
+	logger.Printf(ctx, "redis: successfully connected to %v", cfg.GetAddrs())
+	return redisClient, nil
+}
+
+// newUniversalClient builds the underlying client for the deployment topology named
+// by cfg.Mode. Cluster and Sentinel are constructed explicitly (redis.NewClusterClient
+// / redis.NewFailoverClient) rather than left to redis.NewUniversalClient's
+// Addrs/MasterName inference, so e.g. a cluster-mode config with a single seed
+// address (a common shape for a cluster configuration endpoint) still yields a
+// cluster client instead of silently falling back to a single-node one. An empty
+// Mode keeps the original inferred behavior for backward compatibility.
+func newUniversalClient(cfg *Config) redis.UniversalClient {
+	switch cfg.Mode {
+	case ModeCluster:
+		return redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:           cfg.GetAddrs(),
+			Password:        cfg.Password,
+			DialTimeout:     cfg.DialTimeout,
+			ReadTimeout:     cfg.ReadTimeout,
+			WriteTimeout:    cfg.WriteTimeout,
+			PoolSize:        cfg.PoolSize,
+			MinIdleConns:    cfg.MinIdleConns,
+			ConnMaxIdleTime: cfg.IdleTimeout,
+		})
+	case ModeSentinel:
+		return redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:      cfg.MasterName,
+			SentinelAddrs:   cfg.GetAddrs(),
+			Password:        cfg.Password,
+			DB:              cfg.DB,
+			DialTimeout:     cfg.DialTimeout,
+			ReadTimeout:     cfg.ReadTimeout,
+			WriteTimeout:    cfg.WriteTimeout,
+			PoolSize:        cfg.PoolSize,
+			MinIdleConns:    cfg.MinIdleConns,
+			ConnMaxIdleTime: cfg.IdleTimeout,
+		})
+	case ModeSingle:
+		return redis.NewClient(&redis.Options{
+			Addr:            cfg.GetAddr(),
+			Password:        cfg.Password,
+			DB:              cfg.DB,
+			DialTimeout:     cfg.DialTimeout,
+			ReadTimeout:     cfg.ReadTimeout,
+			WriteTimeout:    cfg.WriteTimeout,
+			PoolSize:        cfg.PoolSize,
+			MinIdleConns:    cfg.MinIdleConns,
+			ConnMaxIdleTime: cfg.IdleTimeout,
+		})
+	default:
+		return redis.NewUniversalClient(&redis.UniversalOptions{
+			Addrs:           cfg.GetAddrs(),
+			MasterName:      cfg.MasterName,
+			Password:        cfg.Password,
+			DB:              cfg.DB,
+			DialTimeout:     cfg.DialTimeout,
+			ReadTimeout:     cfg.ReadTimeout,
+			WriteTimeout:    cfg.WriteTimeout,
+			PoolSize:        cfg.PoolSize,
+			MinIdleConns:    cfg.MinIdleConns,
+			ConnMaxIdleTime: cfg.IdleTimeout,
+		})
+	}
+}
+
+// PoolStats returns connection pool statistics for the underlying client, useful for
+// readiness/liveness dashboards.
+//
+// Example:
+//
+//	stats := redisClient.PoolStats()
+func (r *Client) PoolStats() *redis.PoolStats {
+	return r.Client.PoolStats()
+}
+
+// HealthCheck pings Redis and returns an error if it is unreachable. It's intended
+// for use in readiness probes.
+//
+// Example:
+//
+//	err := redisClient.HealthCheck(ctx)
+func (r *Client) HealthCheck(ctx context.Context) error {
+	return r.Client.Ping(ctx).Err()
 }
 
 // Set stores a key-value pair without expiration
@@ -0,0 +1,197 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// StreamHandler processes a single Streams entry delivered to a consumer group.
+type StreamHandler func(ctx context.Context, msg redis.XMessage) error
+
+// StreamConsumerConfig configures a StreamConsumer.
+type StreamConsumerConfig struct {
+	// Stream is the name of the Redis stream to consume.
+	Stream string
+	// Group is the consumer group name. It is created automatically (XGROUP CREATE
+	// MKSTREAM) if it doesn't already exist.
+	Group string
+	// Consumer is this consumer's name within Group.
+	Consumer string
+	// Concurrency is the number of goroutines reading from the group concurrently.
+	Concurrency int
+	// ClaimIdle is how long an entry must sit unacknowledged before another consumer
+	// in the group may claim and retry it.
+	ClaimIdle time.Duration
+	// MaxDeliveries is the number of delivery attempts allowed before an entry is
+	// considered poison and moved to DeadLetterStream.
+	MaxDeliveries int64
+	// DeadLetterStream is where entries that exceed MaxDeliveries are re-published.
+	DeadLetterStream string
+}
+
+// StreamConsumer runs a worker pool against a Redis Streams consumer group, giving
+// at-least-once delivery with automatic retry of unacknowledged entries and a
+// dead-letter stream for entries that repeatedly fail.
+type StreamConsumer struct {
+	client  *Client
+	cfg     StreamConsumerConfig
+	handler StreamHandler
+}
+
+// NewStreamConsumer builds a StreamConsumer that dispatches entries from
+// cfg.Stream to handler.
+//
+// Example:
+//
+//	consumer := redis.NewStreamConsumer(redisClient, redis.StreamConsumerConfig{
+//	    Stream:           "orders",
+//	    Group:            "workers",
+//	    Consumer:         "worker-1",
+//	    Concurrency:      4,
+//	    ClaimIdle:        time.Minute,
+//	    MaxDeliveries:    5,
+//	    DeadLetterStream: "orders:dead",
+//	}, handleOrder)
+func NewStreamConsumer(client *Client, cfg StreamConsumerConfig, handler StreamHandler) *StreamConsumer {
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = 1
+	}
+	if cfg.ClaimIdle <= 0 {
+		cfg.ClaimIdle = time.Minute
+	}
+	if cfg.MaxDeliveries <= 0 {
+		cfg.MaxDeliveries = 5
+	}
+	return &StreamConsumer{client: client, cfg: cfg, handler: handler}
+}
+
+// Run ensures the consumer group exists, then starts the worker pool and blocks
+// until ctx is done.
+//
+// Example:
+//
+//	err := consumer.Run(ctx)
+func (c *StreamConsumer) Run(ctx context.Context) error {
+	err := c.client.Client.XGroupCreateMkStream(ctx, c.cfg.Stream, c.cfg.Group, "$").Err()
+	if err != nil && !strings.Contains(err.Error(), "BUSYGROUP") {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(c.cfg.Concurrency)
+	for i := 0; i < c.cfg.Concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			c.loop(ctx)
+		}()
+	}
+	wg.Wait()
+	return nil
+}
+
+// loop reads new entries for this consumer and reclaims entries abandoned by dead
+// consumers until ctx is done. A real XREADGROUP error (e.g. the server being
+// unreachable during an outage) is paced with a capped exponential backoff instead
+// of retried immediately.
+func (c *StreamConsumer) loop(ctx context.Context) {
+	bo := newBackoff(100*time.Millisecond, 5*time.Second)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		streams, err := c.client.XReadGroup(ctx, c.cfg.Group, c.cfg.Consumer, c.cfg.Stream)
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				c.reclaim(ctx)
+				continue
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("redis: stream consumer XREADGROUP failed: %v", err)
+			bo.wait(ctx)
+			continue
+		}
+		bo.reset()
+
+		for _, stream := range streams {
+			for _, msg := range stream.Messages {
+				c.process(ctx, msg, 1)
+			}
+		}
+
+		c.reclaim(ctx)
+	}
+}
+
+// reclaim claims entries that have been pending for longer than ClaimIdle, typically
+// because their original consumer crashed, and retries or dead-letters them based on
+// their delivery count.
+func (c *StreamConsumer) reclaim(ctx context.Context) {
+	pending, err := c.client.Client.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: c.cfg.Stream,
+		Group:  c.cfg.Group,
+		Idle:   c.cfg.ClaimIdle,
+		Start:  "-",
+		End:    "+",
+		Count:  100,
+	}).Result()
+	if err != nil {
+		return
+	}
+
+	for _, entry := range pending {
+		messages, err := c.client.XClaim(ctx, c.cfg.Stream, c.cfg.Group, c.cfg.Consumer, c.cfg.ClaimIdle, entry.ID)
+		if err != nil || len(messages) == 0 {
+			continue
+		}
+		c.process(ctx, messages[0], entry.RetryCount+1)
+	}
+}
+
+// process dispatches msg to the handler, acknowledging it on success. On failure it
+// either leaves it pending for retry or, once deliveries reaches MaxDeliveries,
+// re-publishes it to DeadLetterStream and acknowledges the original.
+func (c *StreamConsumer) process(ctx context.Context, msg redis.XMessage, deliveries int64) {
+	if err := c.dispatch(ctx, msg); err == nil {
+		_, _ = c.client.XAck(ctx, c.cfg.Stream, c.cfg.Group, msg.ID)
+		return
+	}
+
+	if deliveries < c.cfg.MaxDeliveries || c.cfg.DeadLetterStream == "" {
+		return
+	}
+
+	deadValues := make(map[string]interface{}, len(msg.Values)+1)
+	for k, v := range msg.Values {
+		deadValues[k] = v
+	}
+	deadValues["originalId"] = msg.ID
+	if _, err := c.client.XAdd(ctx, c.cfg.DeadLetterStream, deadValues); err != nil {
+		log.Printf("redis: stream consumer failed to dead-letter %s: %v", msg.ID, err)
+		return
+	}
+	_, _ = c.client.XAck(ctx, c.cfg.Stream, c.cfg.Group, msg.ID)
+}
+
+// dispatch invokes the handler for msg, recovering from and logging any panic so a
+// single poison message can't take down the whole consumer.
+func (c *StreamConsumer) dispatch(ctx context.Context, msg redis.XMessage) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			log.Printf("redis: stream consumer handler panicked: %v", rec)
+			err = errors.New("redis: stream consumer handler panicked")
+		}
+	}()
+	return c.handler(ctx, msg)
+}
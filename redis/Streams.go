@@ -0,0 +1,74 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// XAdd appends values as a new entry to stream, returning the generated entry ID.
+//
+// Example:
+//
+//	id, err := redisClient.XAdd(ctx, "orders", map[string]interface{}{"orderId": "42"})
+func (r *Client) XAdd(ctx context.Context, stream string, values map[string]interface{}) (string, error) {
+	return r.Client.XAdd(ctx, &redis.XAddArgs{Stream: stream, Values: values}).Result()
+}
+
+// XReadGroup reads new entries (ones never delivered to any consumer in group) from
+// streams on behalf of consumer, blocking for up to 5 seconds when nothing is
+// immediately available.
+//
+// Example:
+//
+//	entries, err := redisClient.XReadGroup(ctx, "workers", "worker-1", "orders")
+func (r *Client) XReadGroup(ctx context.Context, group, consumer string, streams ...string) ([]redis.XStream, error) {
+	ids := make([]string, len(streams))
+	for i := range ids {
+		ids[i] = ">"
+	}
+	return r.Client.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    group,
+		Consumer: consumer,
+		Streams:  append(streams, ids...),
+		Block:    5 * time.Second,
+	}).Result()
+}
+
+// XAck acknowledges that the given entry IDs on stream have been processed by group,
+// removing them from the group's pending entries list.
+//
+// Example:
+//
+//	n, err := redisClient.XAck(ctx, "orders", "workers", id)
+func (r *Client) XAck(ctx context.Context, stream, group string, ids ...string) (int64, error) {
+	return r.Client.XAck(ctx, stream, group, ids...).Result()
+}
+
+// XPending returns a summary of group's pending entries on stream: the count, the ID
+// range, and per-consumer totals.
+//
+// Example:
+//
+//	summary, err := redisClient.XPending(ctx, "orders", "workers")
+func (r *Client) XPending(ctx context.Context, stream, group string) (*redis.XPending, error) {
+	return r.Client.XPending(ctx, stream, group).Result()
+}
+
+// XClaim transfers ownership of the given pending entry IDs on stream to consumer,
+// provided they have been idle for at least minIdle. It's used to recover entries
+// whose original consumer died before acknowledging them.
+//
+// Example:
+//
+//	messages, err := redisClient.XClaim(ctx, "orders", "workers", "worker-2", time.Minute, id)
+func (r *Client) XClaim(ctx context.Context, stream, group, consumer string, minIdle time.Duration, ids ...string) ([]redis.XMessage, error) {
+	return r.Client.XClaim(ctx, &redis.XClaimArgs{
+		Stream:   stream,
+		Group:    group,
+		Consumer: consumer,
+		MinIdle:  minIdle,
+		Messages: ids,
+	}).Result()
+}
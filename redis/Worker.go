@@ -0,0 +1,113 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Handler processes a single job payload popped from a Worker's queue.
+type Handler func(ctx context.Context, payload string) error
+
+// Worker runs a pool of goroutines that pull job payloads from a Redis list via
+// blocking BRPOP and dispatch them to a Handler. Jobs are enqueued with
+// Client.Enqueue or Client.EnqueueJSON.
+type Worker struct {
+	client       *Client
+	key          string
+	handler      Handler
+	concurrency  int
+	blockTimeout time.Duration
+}
+
+// NewWorker builds a Worker that pops jobs from the Redis list at key and dispatches
+// them to handler using concurrency concurrent goroutines.
+//
+// Example:
+//
+//	worker := redis.NewWorker(redisClient, "jobs", 4, func(ctx context.Context, payload string) error {
+//	    return process(payload)
+//	})
+func NewWorker(client *Client, key string, concurrency int, handler Handler) *Worker {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	return &Worker{
+		client:       client,
+		key:          key,
+		handler:      handler,
+		concurrency:  concurrency,
+		blockTimeout: 5 * time.Second,
+	}
+}
+
+// Run starts the worker pool and blocks until ctx is done, at which point every
+// goroutine finishes its current job and Run returns.
+//
+// Example:
+//
+//	err := worker.Run(ctx)
+func (w *Worker) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	wg.Add(w.concurrency)
+
+	for i := 0; i < w.concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			w.loop(ctx)
+		}()
+	}
+
+	wg.Wait()
+	return nil
+}
+
+// loop repeatedly pops a job from the queue and dispatches it to the handler until
+// ctx is done. A real BRPOP error (e.g. the server being unreachable during an
+// outage) is paced with a capped exponential backoff instead of retried immediately.
+func (w *Worker) loop(ctx context.Context) {
+	bo := newBackoff(100*time.Millisecond, 5*time.Second)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		result, err := w.client.Client.BRPop(ctx, w.blockTimeout, w.key).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				continue
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("redis: worker BRPOP failed: %v", err)
+			bo.wait(ctx)
+			continue
+		}
+		bo.reset()
+
+		// BRPop returns a [key, value] pair.
+		w.dispatch(ctx, result[1])
+	}
+}
+
+// dispatch invokes the handler for payload, recovering from and logging any panic so
+// a single bad job can't take down the whole worker pool.
+func (w *Worker) dispatch(ctx context.Context, payload string) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			log.Printf("redis: worker handler panicked: %v", rec)
+		}
+	}()
+
+	if err := w.handler(ctx, payload); err != nil {
+		log.Printf("redis: worker handler failed: %v", err)
+	}
+}
@@ -0,0 +1,45 @@
+package redis
+
+import (
+	"context"
+	"time"
+)
+
+// backoff paces retries after a transient command error with a capped exponential
+// delay, so an outage (e.g. the server refusing connections) turns into a slow
+// retry loop instead of a busy spin that floods logs and burns CPU.
+type backoff struct {
+	base    time.Duration
+	max     time.Duration
+	attempt int
+}
+
+// newBackoff builds a backoff starting at base and capped at max.
+func newBackoff(base, max time.Duration) *backoff {
+	return &backoff{base: base, max: max}
+}
+
+// wait sleeps for the current backoff delay, or until ctx is done, then grows the
+// delay for the next call.
+func (b *backoff) wait(ctx context.Context) {
+	delay := b.base << b.attempt
+	if delay <= 0 || delay > b.max {
+		delay = b.max
+	}
+	if b.attempt < 62 {
+		b.attempt++
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
+
+// reset clears the backoff after a successful call, so the next failure starts at
+// base again instead of continuing to grow.
+func (b *backoff) reset() {
+	b.attempt = 0
+}